@@ -4,11 +4,17 @@ package grouper
 
 import (
 	"context"
+	"errors"
 	"sync"
+	"sync/atomic"
 )
 
 // A DynamicGroup is a collection of goroutines working on subtasks that are part of
 // the same overall task. It can have an unbounded number of goroutines.
+//
+// Unlike Group, a DynamicGroup's Go may be called concurrently from multiple
+// goroutines, including from within a function already running under Go, as
+// long as at least one task submitted to the group is still running.
 type DynamicGroup[V any] struct {
 	ctx    context.Context
 	cancel func()
@@ -16,46 +22,199 @@ type DynamicGroup[V any] struct {
 	errOnce sync.Once
 	err     error
 
-	count     uint
-	valueChan chan V
+	wg    sync.WaitGroup
+	count int64 // atomically incremented, assigns each Go call its Result.Index
+
+	mu     sync.Mutex
+	values []V
+	errs   []error
+
+	results          chan Result[V]
+	resultsRequested bool
+
+	sem           chan struct{}
+	collectErrors bool
+
+	recoverPanics bool
+}
+
+// Result carries the outcome of a single function passed to Go: the value
+// and error it returned, and the index (in submission order) of the Go call
+// that produced it. It's delivered over the channel returned by Results.
+type Result[V any] struct {
+	Value V
+	Err   error
+	Index int
 }
 
 // NewDynamic returns a new DynamicGroup and an associated Context derived from ctx.
 //
 // The derived Context is canceled the first time a function passed to Go
-// returns a non-nil error or the first time Wait returns, whichever occurs
-// first.
+// returns a non-nil error (unless CollectErrors was called) or the first
+// time Wait, WaitAll, or Close returns, whichever occurs first.
 func NewDynamic[V any](ctx context.Context) (*DynamicGroup[V], context.Context) {
-	g := &DynamicGroup[V]{valueChan: make(chan V)}
+	g := &DynamicGroup[V]{results: make(chan Result[V])}
 	g.ctx, g.cancel = context.WithCancel(ctx)
 	return g, g.ctx
 }
 
-// Wait blocks until all function calls passed into New have returned, then
+// Results returns a channel that receives one Result for each call to Go as
+// it completes, letting callers consume outputs as they arrive instead of
+// waiting for Wait to return the full slice. This is the pipeline pattern:
+// a producer feeds Go calls while a consumer ranges over Results and can
+// early-exit on the first error via the context returned by NewDynamic.
+// The channel closes once every task started by Go has completed and Wait,
+// WaitAll or Close has been called.
+// Once the group's context is canceled (by the first non-nil error, or by
+// the parent context), a task whose Result has not yet been received drops
+// it instead of blocking, so the channel may then carry fewer Results than
+// there were calls to Go.
+func (g *DynamicGroup[V]) Results() <-chan Result[V] {
+	g.resultsRequested = true
+	return g.results
+}
+
+// Wait blocks until all function calls passed into Go have returned, then
 // returns the successfully returned values and the first non-nil error (if any).
 // Note that the values slice contains the results of every function call, whether it errored or not. It can be sparse in the case of multiple errors.
-// Wait should only be called once per DynamicGroup.
+// Wait should only be called once per DynamicGroup. Every call to Go must either happen-before it or be made from a task that is still running.
 func (g *DynamicGroup[V]) Wait() ([]V, error) {
-	values := make([]V, g.count)
-	for i := range values {
-		values[i] = <-g.valueChan
+	g.wg.Wait()
+	if g.resultsRequested {
+		close(g.results)
 	}
-
-	g.count = 0
+	g.mu.Lock()
+	values := g.values
+	g.mu.Unlock()
 	g.cancel()
 	return values, g.err
 }
 
+// CollectErrors puts the DynamicGroup into batch mode: a non-nil error
+// returned by a function passed to Go no longer cancels the group's
+// context, so every task keeps running to completion. WaitAll, not Wait,
+// must be used to collect every value alongside a joined error report.
+// CollectErrors must be called before any call to Go.
+func (g *DynamicGroup[V]) CollectErrors() *DynamicGroup[V] {
+	g.collectErrors = true
+	return g
+}
+
+// WaitAll blocks until all function calls passed into Go have returned,
+// then returns every value alongside errors.Join of every non-nil error.
+// WaitAll only runs every task to completion without canceling the group's
+// context on the first error if CollectErrors was called beforehand; since
+// skipping that call would silently give WaitAll the same early-cancellation
+// behavior as Wait, WaitAll panics if CollectErrors was not called. This
+// suits batch workloads (bulk imports, fan-out RPCs) where every result
+// matters and callers want partial success plus a complete error report.
+// The returned error supports errors.Is and errors.As against any
+// individual task's error.
+// WaitAll should only be called once per DynamicGroup. Every call to Go must either happen-before it or be made from a task that is still running.
+func (g *DynamicGroup[V]) WaitAll() ([]V, error) {
+	if !g.collectErrors {
+		panic("grouper: WaitAll requires CollectErrors to have been called before any call to Go")
+	}
+	g.wg.Wait()
+	if g.resultsRequested {
+		close(g.results)
+	}
+	g.mu.Lock()
+	values := g.values
+	err := errors.Join(g.errs...)
+	g.mu.Unlock()
+	g.cancel()
+	return values, err
+}
+
+// Close blocks until all function calls passed into Go have returned, then
+// closes the channel returned by Results and returns the first non-nil
+// error (if any), without collecting values into a slice. Use Close instead
+// of Wait when only the streaming Results channel is needed.
+// Close should only be called once per DynamicGroup. Every call to Go must either happen-before it or be made from a task that is still running.
+func (g *DynamicGroup[V]) Close() error {
+	g.wg.Wait()
+	if g.resultsRequested {
+		close(g.results)
+	}
+	g.cancel()
+	return g.err
+}
+
+// WithRecover puts the DynamicGroup into panic-safe mode: a panic in any
+// function passed to Go is recovered, converted into a *PanicError carrying
+// the recovered value and a stack trace, and treated like any other non-nil
+// error (it cancels the group's context and is returned by Wait). Without
+// WithRecover, a panicking function crashes the process as usual.
+func (g *DynamicGroup[V]) WithRecover() *DynamicGroup[V] {
+	g.recoverPanics = true
+	return g
+}
+
+// SetLimit caps the number of active goroutines spawned by Go to n.
+// Once the limit is reached, Go blocks until an in-flight task finishes;
+// TryGo returns false immediately instead of blocking.
+// A negative n removes the limit. SetLimit must be called before any
+// call to Go or TryGo that would be affected by it.
+func (g *DynamicGroup[V]) SetLimit(n int) {
+	if n < 0 {
+		g.sem = nil
+		return
+	}
+	g.sem = make(chan struct{}, n)
+}
+
 // Go calls the given function in a new goroutine.
 //
 // The first call to return a non-nil error cancels the group; its error will be
-// returned by Wait.
-// Go should not be called after Wait has been called.
+// returned by Wait. Go may be called concurrently from multiple goroutines,
+// including from within a function already running under Go, as long as at
+// least one task submitted to the group is still running.
+// Go should not be called after Wait or Close has returned.
+// If SetLimit has been called, Go blocks until the number of active goroutines
+// drops below the limit.
 func (g *DynamicGroup[V]) Go(f func(context.Context) (V, error)) {
-	g.count++
-	go func() {
-		v, err := f(g.ctx)
-		if err != nil {
+	g.wg.Add(1)
+	if g.sem != nil {
+		g.sem <- struct{}{}
+	}
+	idx := int(atomic.AddInt64(&g.count, 1) - 1)
+	go g.run(idx, f)
+}
+
+// TryGo calls the given function in a new goroutine only if the group's
+// limit, set via SetLimit, has not been reached. It reports whether the
+// function was started. If no limit has been set, TryGo always starts the
+// function and returns true. Like Go, TryGo may be called concurrently from
+// multiple goroutines.
+func (g *DynamicGroup[V]) TryGo(f func(context.Context) (V, error)) bool {
+	g.wg.Add(1)
+	if g.sem != nil {
+		select {
+		case g.sem <- struct{}{}:
+		default:
+			g.wg.Done()
+			return false
+		}
+	}
+	idx := int(atomic.AddInt64(&g.count, 1) - 1)
+	go g.run(idx, f)
+	return true
+}
+
+func (g *DynamicGroup[V]) run(idx int, f func(context.Context) (V, error)) {
+	defer g.wg.Done()
+	defer func() {
+		if g.sem != nil {
+			<-g.sem
+		}
+	}()
+	v, err := g.call(f)
+	if err != nil {
+		g.mu.Lock()
+		g.errs = append(g.errs, err)
+		g.mu.Unlock()
+		if !g.collectErrors {
 			g.errOnce.Do(func() {
 				g.err = err
 				if g.cancel != nil {
@@ -63,6 +222,38 @@ func (g *DynamicGroup[V]) Go(f func(context.Context) (V, error)) {
 				}
 			})
 		}
-		g.valueChan <- v
-	}()
+	}
+	g.store(idx, v)
+	if g.resultsRequested {
+		select {
+		case g.results <- Result[V]{Value: v, Err: err, Index: idx}:
+		case <-g.ctx.Done():
+		}
+	}
+}
+
+// store records v at idx in g.values, growing the slice as needed. It's
+// called from every worker goroutine, so access is serialized by g.mu.
+func (g *DynamicGroup[V]) store(idx int, v V) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if idx >= len(g.values) {
+		grown := make([]V, idx+1)
+		copy(grown, g.values)
+		g.values = grown
+	}
+	g.values[idx] = v
+}
+
+// call invokes f, recovering and converting any panic into a *PanicError
+// when the group is in recover mode (see WithRecover).
+func (g *DynamicGroup[V]) call(f func(context.Context) (V, error)) (v V, err error) {
+	if g.recoverPanics {
+		defer func() {
+			if r := recover(); r != nil {
+				err = recoverToError(r)
+			}
+		}()
+	}
+	return f(g.ctx)
 }
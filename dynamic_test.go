@@ -6,6 +6,9 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"runtime"
+	"sync"
+	"sync/atomic"
 	"testing"
 
 	"github.com/danlock/grouper"
@@ -74,6 +77,234 @@ func ExampleDynamicGroup_parallel() {
 	// video result for "golang"
 }
 
+// ExampleDynamicGroup_setLimit illustrates bounding outbound HTTP concurrency
+// when fetching a large number of URLs, instead of wrapping every task in a
+// hand-rolled semaphore.
+func ExampleDynamicGroup_setLimit() {
+	g, _ := grouper.NewDynamic[*http.Response](context.Background())
+	g.SetLimit(2)
+	var urls = []string{
+		"http://www.golang.org/",
+		"http://www.google.com/",
+		"http://www.somestupidname.com/",
+	}
+	for _, url := range urls {
+		url := url // https://golang.org/doc/faq#closures_and_goroutines
+		g.Go(func(context.Context) (*http.Response, error) {
+			resp, err := http.Get(url)
+			if err == nil {
+				resp.Body.Close()
+			}
+			return resp, err
+		})
+	}
+	if _, err := g.Wait(); err == nil {
+		fmt.Println("Successfully fetched all URLs.")
+	}
+}
+
+func TestDynamicGroupSetLimit(t *testing.T) {
+	g, _ := grouper.NewDynamic[int](context.Background())
+	g.SetLimit(2)
+
+	var active int32
+	var maxActive int32
+	block := make(chan struct{})
+
+	task := func(context.Context) (int, error) {
+		n := atomic.AddInt32(&active, 1)
+		for {
+			old := atomic.LoadInt32(&maxActive)
+			if n <= old || atomic.CompareAndSwapInt32(&maxActive, old, n) {
+				break
+			}
+		}
+		<-block
+		atomic.AddInt32(&active, -1)
+		return 0, nil
+	}
+
+	var submitted sync.WaitGroup
+	submitted.Add(3)
+	for i := 0; i < 3; i++ {
+		go func() {
+			defer submitted.Done()
+			g.Go(task)
+		}()
+	}
+
+	// Give the limited goroutines a chance to start before releasing them.
+	for atomic.LoadInt32(&active) < 2 {
+		runtime.Gosched()
+	}
+	if n := atomic.LoadInt32(&active); n > 2 {
+		t.Errorf("active = %d, want at most 2", n)
+	}
+	close(block)
+	// The third Go call returns only once a slot frees up; it must return
+	// before Wait is called, since no task may be left running to cover it.
+	submitted.Wait()
+
+	if _, err := g.Wait(); err != nil {
+		t.Errorf("g.Wait() = %v; want nil", err)
+	}
+	if atomic.LoadInt32(&maxActive) > 2 {
+		t.Errorf("maxActive = %d, want at most 2", maxActive)
+	}
+}
+
+func TestDynamicGroupTryGo(t *testing.T) {
+	g, _ := grouper.NewDynamic[int](context.Background())
+	g.SetLimit(1)
+	block := make(chan struct{})
+
+	if !g.TryGo(func(context.Context) (int, error) {
+		<-block
+		return 0, nil
+	}) {
+		t.Fatal("TryGo() = false on first call; want true")
+	}
+
+	if g.TryGo(func(context.Context) (int, error) { return 0, nil }) {
+		t.Error("TryGo() = true while at limit; want false")
+	}
+
+	close(block)
+	if _, err := g.Wait(); err != nil {
+		t.Errorf("g.Wait() = %v; want nil", err)
+	}
+}
+
+// ExampleDynamicGroup_results illustrates the pipeline pattern from the
+// errgroup MD5-all example: a producer feeds Go calls while a consumer
+// ranges over Results, consuming completed values as they arrive instead of
+// waiting for Wait to return the full slice.
+func ExampleDynamicGroup_results() {
+	g, ctx := grouper.NewDynamic[Result](context.Background())
+	results := g.Results()
+
+	go func() {
+		for _, search := range []Search{Web, Image, Video} {
+			search := search
+			g.Go(func(context.Context) (Result, error) { return search(ctx, "golang") })
+		}
+		g.Close()
+	}()
+
+	var sum int
+	for range results {
+		sum++
+	}
+	fmt.Println(sum)
+	// Output:
+	// 3
+}
+
+func TestDynamicGroupResults(t *testing.T) {
+	g, _ := grouper.NewDynamic[int](context.Background())
+	results := g.Results()
+
+	want := []int{10, 20, 30}
+	go func() {
+		for _, v := range want {
+			v := v
+			g.Go(func(context.Context) (int, error) { return v, nil })
+		}
+		g.Close()
+	}()
+
+	got := make([]int, len(want))
+	var n int
+	for r := range results {
+		got[r.Index] = r.Value
+		n++
+	}
+
+	if n != len(want) {
+		t.Fatalf("got %d results; want %d", n, len(want))
+	}
+	for i, v := range got {
+		if v != want[i] {
+			t.Errorf("got[%d] = %d; want %d", i, v, want[i])
+		}
+	}
+}
+
+func TestDynamicGroupWaitAll(t *testing.T) {
+	err1 := errors.New("grouper_test: 1")
+	err2 := errors.New("grouper_test: 2")
+
+	g, ctx := grouper.NewDynamic[int](context.Background())
+	g.CollectErrors()
+
+	block := make(chan struct{})
+	g.Go(func(context.Context) (int, error) {
+		<-block
+		return 1, err1
+	})
+	g.Go(func(context.Context) (int, error) { return 2, nil })
+	g.Go(func(context.Context) (int, error) { return 3, err2 })
+
+	// The third task's error must not cancel the context while the first
+	// task is still running, since CollectErrors puts the group in batch mode.
+	select {
+	case <-ctx.Done():
+		t.Fatal("ctx was canceled before all tasks finished; CollectErrors should suppress that")
+	default:
+	}
+	close(block)
+
+	values, err := g.WaitAll()
+	if err == nil {
+		t.Fatal("g.WaitAll() err = nil; want a joined error")
+	}
+	if !errors.Is(err, err1) || !errors.Is(err, err2) {
+		t.Errorf("g.WaitAll() err = %v; want errors.Is match for both err1 and err2", err)
+	}
+	want := map[int]bool{1: true, 2: true, 3: true}
+	for _, v := range values {
+		if !want[v] {
+			t.Errorf("unexpected value %d in %v", v, values)
+		}
+	}
+}
+
+func TestDynamicGroupWaitAllWithoutCollectErrors(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("g.WaitAll() did not panic without a prior call to CollectErrors")
+		}
+	}()
+
+	g, _ := grouper.NewDynamic[int](context.Background())
+	g.Go(func(context.Context) (int, error) { return 0, nil })
+	g.WaitAll()
+}
+
+func TestDynamicGroupWithRecover(t *testing.T) {
+	g, _ := grouper.NewDynamic[int](context.Background())
+	g.WithRecover()
+
+	g.Go(func(context.Context) (int, error) {
+		panic("boom")
+	})
+
+	_, err := g.Wait()
+	if err == nil {
+		t.Fatal("g.Wait() = nil; want a *grouper.PanicError")
+	}
+	if !errors.Is(err, grouper.ErrPanic) {
+		t.Errorf("errors.Is(err, grouper.ErrPanic) = false; want true")
+	}
+	var panicErr *grouper.PanicError
+	if !errors.As(err, &panicErr) {
+		t.Fatalf("errors.As(err, &panicErr) = false; want true")
+	}
+	if panicErr.Value != "boom" {
+		t.Errorf("panicErr.Value = %v; want %q", panicErr.Value, "boom")
+	}
+}
+
 func TestDynamicGroup(t *testing.T) {
 	err1 := errors.New("grouper_test: 1")
 	err2 := errors.New("grouper_test: 2")
@@ -148,3 +379,72 @@ func TestDynamicWithContext(t *testing.T) {
 		}
 	}
 }
+
+// TestDynamicGroupConcurrentGo fans out concurrent calls to Go from many
+// goroutines at once, to be run with -race to catch any unsynchronized
+// access to the group's internals. submitted ensures every Go call (and the
+// Add it performs under the hood) happens-before Wait is called, since
+// calling Go concurrently with Wait once the group is empty is not
+// supported by sync.WaitGroup.
+func TestDynamicGroupConcurrentGo(t *testing.T) {
+	const n = 100
+	g, _ := grouper.NewDynamic[int](context.Background())
+
+	var start, submitted sync.WaitGroup
+	start.Add(n)
+	submitted.Add(n)
+	for i := 0; i < n; i++ {
+		i := i
+		go func() {
+			defer submitted.Done()
+			start.Done()
+			start.Wait()
+			g.Go(func(context.Context) (int, error) { return i, nil })
+		}()
+	}
+	submitted.Wait()
+
+	values, err := g.Wait()
+	if err != nil {
+		t.Fatalf("g.Wait() = %v; want nil", err)
+	}
+	if len(values) != n {
+		t.Fatalf("len(values) = %d; want %d", len(values), n)
+	}
+	seen := make(map[int]bool, n)
+	for _, v := range values {
+		seen[v] = true
+	}
+	for i := 0; i < n; i++ {
+		if !seen[i] {
+			t.Errorf("value %d missing from result", i)
+		}
+	}
+}
+
+// TestDynamicGroupGoFromWithinGo calls Go from inside a function already
+// running under Go, which is safe as long as at least one task is still
+// running, and should be run with -race to catch any unsynchronized access.
+func TestDynamicGroupGoFromWithinGo(t *testing.T) {
+	const depth = 10
+	g, _ := grouper.NewDynamic[int](context.Background())
+
+	var spawn func(n int)
+	spawn = func(n int) {
+		g.Go(func(context.Context) (int, error) {
+			if n > 0 {
+				spawn(n - 1)
+			}
+			return n, nil
+		})
+	}
+	spawn(depth)
+
+	values, err := g.Wait()
+	if err != nil {
+		t.Fatalf("g.Wait() = %v; want nil", err)
+	}
+	if len(values) != depth+1 {
+		t.Fatalf("len(values) = %d; want %d", len(values), depth+1)
+	}
+}
@@ -4,6 +4,7 @@ package grouper
 
 import (
 	"context"
+	"errors"
 	"sync"
 )
 
@@ -16,6 +17,9 @@ type Group[V any] struct {
 	err     error
 
 	funcs []func(context.Context) (V, error)
+
+	recoverPanics bool
+	collectErrors bool
 }
 
 // New returns a new Group and an associated Context derived from ctx.
@@ -30,6 +34,25 @@ func New[V any](funcs ...func(context.Context) (V, error)) *Group[V] {
 	return &Group[V]{funcs: funcs}
 }
 
+// WithRecover puts the Group into panic-safe mode: a panic in any function
+// passed to New is recovered, converted into a *PanicError carrying the
+// recovered value and a stack trace, and treated like any other non-nil
+// error (it cancels the Group's context and is returned by Wait). Without
+// WithRecover, a panicking function crashes the process as usual.
+func (g *Group[V]) WithRecover() *Group[V] {
+	g.recoverPanics = true
+	return g
+}
+
+// CollectErrors puts the Group into batch mode: WaitAll, not Wait, must be
+// used to run every function passed to New to completion and collect every
+// value alongside a joined error report. CollectErrors must be called before
+// Wait or WaitAll.
+func (g *Group[V]) CollectErrors() *Group[V] {
+	g.collectErrors = true
+	return g
+}
+
 // Wait blocks until all function calls passed into New have returned, then
 // returns the successfully returned values and the first non-nil error (if any).
 // Finally a Wait that returned an error will continue returning that same error on all future calls.
@@ -43,7 +66,7 @@ func (g *Group[V]) Wait(ctx context.Context) ([]V, error) {
 		i, f := i, f
 		go func() {
 			defer g.wg.Done()
-			v, err := f(ctx)
+			v, err := g.call(ctx, f)
 			if err != nil {
 				g.errOnce.Do(func() {
 					g.err = err
@@ -58,3 +81,48 @@ func (g *Group[V]) Wait(ctx context.Context) ([]V, error) {
 	g.wg.Wait()
 	return values, g.err
 }
+
+// WaitAll blocks until all function calls passed into New have returned,
+// then returns every returned value alongside errors.Join of every non-nil
+// error. Unlike Wait, WaitAll does not cancel ctx on the first error; every
+// function passed to New runs to completion, which suits batch workloads
+// (bulk imports, fan-out RPCs) where every result matters and callers want
+// partial success plus a complete error report. The returned error supports
+// errors.Is and errors.As against any individual task's error.
+// WaitAll panics unless CollectErrors was called first, so that running
+// every function to completion is always an explicit choice rather than a
+// silent difference from Wait.
+func (g *Group[V]) WaitAll(ctx context.Context) ([]V, error) {
+	if !g.collectErrors {
+		panic("grouper: WaitAll requires CollectErrors to have been called first")
+	}
+	g.wg.Add(len(g.funcs))
+
+	values := make([]V, len(g.funcs))
+	errs := make([]error, len(g.funcs))
+	for i, f := range g.funcs {
+		i, f := i, f
+		go func() {
+			defer g.wg.Done()
+			v, err := g.call(ctx, f)
+			values[i] = v
+			errs[i] = err
+		}()
+	}
+
+	g.wg.Wait()
+	return values, errors.Join(errs...)
+}
+
+// call invokes f, recovering and converting any panic into a *PanicError
+// when the Group is in recover mode (see WithRecover).
+func (g *Group[V]) call(ctx context.Context, f func(context.Context) (V, error)) (v V, err error) {
+	if g.recoverPanics {
+		defer func() {
+			if r := recover(); r != nil {
+				err = recoverToError(r)
+			}
+		}()
+	}
+	return f(ctx)
+}
@@ -166,6 +166,67 @@ func TestWithContext(t *testing.T) {
 	}
 }
 
+func TestGroupWithRecover(t *testing.T) {
+	g := grouper.New(func(context.Context) (int, error) {
+		panic("boom")
+	}).WithRecover()
+
+	_, err := g.Wait(context.Background())
+	if err == nil {
+		t.Fatal("g.Wait() = nil; want a *grouper.PanicError")
+	}
+	if !errors.Is(err, grouper.ErrPanic) {
+		t.Errorf("errors.Is(err, grouper.ErrPanic) = false; want true")
+	}
+	var panicErr *grouper.PanicError
+	if !errors.As(err, &panicErr) {
+		t.Fatalf("errors.As(err, &panicErr) = false; want true")
+	}
+	if panicErr.Value != "boom" {
+		t.Errorf("panicErr.Value = %v; want %q", panicErr.Value, "boom")
+	}
+	if len(panicErr.Stack) == 0 {
+		t.Error("panicErr.Stack is empty; want a captured stack trace")
+	}
+}
+
+func TestGroupWaitAll(t *testing.T) {
+	err1 := errors.New("grouper_test: 1")
+	err2 := errors.New("grouper_test: 2")
+
+	funcs := []func(context.Context) (int, error){
+		func(context.Context) (int, error) { return 1, err1 },
+		func(context.Context) (int, error) { return 2, nil },
+		func(context.Context) (int, error) { return 3, err2 },
+	}
+	g := grouper.New(funcs...).CollectErrors()
+
+	values, err := g.WaitAll(context.Background())
+	if err == nil {
+		t.Fatal("g.WaitAll() err = nil; want a joined error")
+	}
+	if !errors.Is(err, err1) || !errors.Is(err, err2) {
+		t.Errorf("g.WaitAll() err = %v; want errors.Is match for both err1 and err2", err)
+	}
+	want := []int{1, 2, 3}
+	for i, v := range values {
+		if v != want[i] {
+			t.Errorf("values[%d] = %d; want %d", i, v, want[i])
+		}
+	}
+}
+
+func TestGroupWaitAllWithoutCollectErrors(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("g.WaitAll() did not panic without a prior call to CollectErrors")
+		}
+	}()
+
+	g := grouper.New(func(context.Context) (int, error) { return 0, nil })
+	g.WaitAll(context.Background())
+}
+
 func TestValueGroup(t *testing.T) {
 	cases := []struct {
 		results []uint
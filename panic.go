@@ -0,0 +1,39 @@
+package grouper
+
+import (
+	"errors"
+	"fmt"
+	"runtime/debug"
+)
+
+// ErrPanic is the sentinel error that every *PanicError wraps, allowing
+// callers to detect a recovered panic with errors.Is(err, grouper.ErrPanic)
+// without matching on the specific value that was recovered.
+var ErrPanic = errors.New("grouper: recovered panic")
+
+// PanicError is returned by Wait when a function passed to Go panics while
+// the group is in recover mode (see WithRecover). It carries the recovered
+// value and the stack trace captured at the point of the panic so that
+// callers can log or re-panic selectively.
+type PanicError struct {
+	// Value is the value passed to panic.
+	Value any
+	// Stack is the stack trace captured at the point of the panic, in the
+	// format produced by debug.Stack.
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("grouper: recovered panic: %v\n%s", e.Value, e.Stack)
+}
+
+// Unwrap lets errors.Is(err, ErrPanic) identify a recovered panic regardless
+// of its underlying value.
+func (e *PanicError) Unwrap() error { return ErrPanic }
+
+// recoverToError turns a value obtained from recover() into a *PanicError,
+// capturing the current stack trace. It must be called directly from a
+// deferred function.
+func recoverToError(r any) error {
+	return &PanicError{Value: r, Stack: debug.Stack()}
+}
@@ -0,0 +1,102 @@
+package grouper
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
+
+// A PoolGroup is a collection of goroutines working on subtasks that are
+// part of the same overall task, executed by a fixed pool of worker
+// goroutines rather than one goroutine per task like DynamicGroup. This
+// suits high-throughput fan-out where task counts can reach the millions
+// and per-task goroutine allocation dominates cost.
+type PoolGroup[V any] struct {
+	ctx    context.Context
+	cancel func()
+
+	errOnce sync.Once
+	err     error
+
+	wg    sync.WaitGroup
+	tasks chan poolTask[V]
+
+	count int64 // atomically incremented, assigns each Go call its result index
+
+	mu     sync.Mutex
+	values []V
+}
+
+type poolTask[V any] struct {
+	idx int
+	f   func(context.Context) (V, error)
+}
+
+// NewPool returns a new PoolGroup backed by n long-lived worker goroutines,
+// and an associated Context derived from ctx.
+//
+// The derived Context is canceled the first time a function passed to Go
+// returns a non-nil error or the first time Wait returns, whichever occurs
+// first.
+func NewPool[V any](ctx context.Context, n int) (*PoolGroup[V], context.Context) {
+	if n <= 0 {
+		panic("grouper.NewPool requires a positive pool size")
+	}
+	g := &PoolGroup[V]{tasks: make(chan poolTask[V])}
+	g.ctx, g.cancel = context.WithCancel(ctx)
+
+	g.wg.Add(n)
+	for i := 0; i < n; i++ {
+		go g.worker()
+	}
+	return g, g.ctx
+}
+
+// Go enqueues f to run on the pool. It blocks until one of the pool's
+// worker goroutines picks it up.
+//
+// The first call to return a non-nil error cancels the group; its error
+// will be returned by Wait.
+// Go should not be called after Wait has been called.
+func (g *PoolGroup[V]) Go(f func(context.Context) (V, error)) {
+	idx := int(atomic.AddInt64(&g.count, 1) - 1)
+	g.tasks <- poolTask[V]{idx: idx, f: f}
+}
+
+// Wait closes the pool's task queue and blocks until every worker goroutine
+// has drained it, then returns the successfully returned values and the
+// first non-nil error (if any).
+// Wait should only be called once per PoolGroup, after every call to Go has happened-before it.
+func (g *PoolGroup[V]) Wait() ([]V, error) {
+	close(g.tasks)
+	g.wg.Wait()
+	g.cancel()
+	return g.values, g.err
+}
+
+func (g *PoolGroup[V]) worker() {
+	defer g.wg.Done()
+	for t := range g.tasks {
+		v, err := t.f(g.ctx)
+		if err != nil {
+			g.errOnce.Do(func() {
+				g.err = err
+				g.cancel()
+			})
+		}
+		g.store(t.idx, v)
+	}
+}
+
+// store records v at idx in g.values, growing the slice as needed. It's
+// called from every worker goroutine, so access is serialized by g.mu.
+func (g *PoolGroup[V]) store(idx int, v V) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if idx >= len(g.values) {
+		grown := make([]V, idx+1)
+		copy(grown, g.values)
+		g.values = grown
+	}
+	g.values[idx] = v
+}
@@ -0,0 +1,138 @@
+package grouper_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/danlock/grouper"
+)
+
+// ExamplePoolGroup_justErrors mirrors the shape of
+// ExampleDynamicGroup_justErrors, running one task per URL on a fixed pool
+// of 2 worker goroutines instead of spawning one goroutine per URL. Each
+// task returns its URL without fetching it.
+func ExamplePoolGroup_justErrors() {
+	g, _ := grouper.NewPool[string](context.Background(), 2)
+	var urls = []string{
+		"http://www.golang.org/",
+		"http://www.google.com/",
+		"http://www.somestupidname.com/",
+	}
+	for _, url := range urls {
+		url := url // https://golang.org/doc/faq#closures_and_goroutines
+		g.Go(func(context.Context) (string, error) { return url, nil })
+	}
+	if _, err := g.Wait(); err == nil {
+		fmt.Println("Successfully fetched all URLs.")
+	}
+	// Output:
+	// Successfully fetched all URLs.
+}
+
+func TestPoolGroup(t *testing.T) {
+	err1 := errors.New("grouper_test: 1")
+
+	cases := []struct {
+		errs []error
+	}{
+		{errs: []error{}},
+		{errs: []error{nil}},
+		{errs: []error{err1}},
+		{errs: []error{err1, nil}},
+		{errs: []error{err1, nil, err1, nil}},
+	}
+
+	for _, tc := range cases {
+		g, _ := grouper.NewPool[int](context.Background(), 3)
+		var firstErr error
+		for i, err := range tc.errs {
+			i, err := i, err
+			g.Go(func(context.Context) (int, error) { return i, err })
+
+			if firstErr == nil && err != nil {
+				firstErr = err
+			}
+		}
+
+		values, gErr := g.Wait()
+		if gErr != firstErr {
+			t.Errorf("after Go(func() error { return err }) for err in %v\n"+
+				"g.Wait() = %v; want %v", tc.errs, gErr, firstErr)
+		}
+		if len(values) != len(tc.errs) {
+			t.Errorf("len(values) = %d; want %d", len(values), len(tc.errs))
+		}
+	}
+}
+
+func TestPoolGroupWithContext(t *testing.T) {
+	errDoom := errors.New("grouper_test: doomed")
+
+	cases := []struct {
+		errs []error
+		want error
+	}{
+		{want: nil},
+		{errs: []error{nil}, want: nil},
+		{errs: []error{errDoom}, want: errDoom},
+		{errs: []error{errDoom, nil}, want: errDoom},
+	}
+
+	for _, tc := range cases {
+		g, ctx := grouper.NewPool[int](context.Background(), 2)
+
+		for _, err := range tc.errs {
+			err := err
+			g.Go(func(context.Context) (int, error) { return 0, err })
+		}
+
+		if _, err := g.Wait(); err != tc.want {
+			t.Errorf("after Go(func() error { return err }) for err in %v\n"+
+				"g.Wait() = %v; want %v", tc.errs, err, tc.want)
+		}
+
+		canceled := false
+		select {
+		case <-ctx.Done():
+			canceled = true
+		default:
+		}
+		if !canceled {
+			t.Errorf("after Go(func() error { return err }) for err in %v\n"+
+				"ctx.Done() was not closed", tc.errs)
+		}
+	}
+}
+
+// BenchmarkPoolGroup and BenchmarkDynamicGroup compare a fixed worker pool
+// against one goroutine per task at a task count high enough for per-task
+// goroutine allocation to dominate.
+func benchmarkTask(context.Context) (int, error) { return 0, nil }
+
+func BenchmarkPoolGroup(b *testing.B) {
+	const tasks = 100_000
+	for i := 0; i < b.N; i++ {
+		g, _ := grouper.NewPool[int](context.Background(), 64)
+		for j := 0; j < tasks; j++ {
+			g.Go(benchmarkTask)
+		}
+		if _, err := g.Wait(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDynamicGroup(b *testing.B) {
+	const tasks = 100_000
+	for i := 0; i < b.N; i++ {
+		g, _ := grouper.NewDynamic[int](context.Background())
+		for j := 0; j < tasks; j++ {
+			g.Go(benchmarkTask)
+		}
+		if _, err := g.Wait(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}